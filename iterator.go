@@ -0,0 +1,151 @@
+package llist
+
+import (
+	"iter"
+
+	"github.com/KarelKubat/lnode"
+)
+
+/*
+All returns an iter.Seq over the nodes of the list, head to tail, for use in a range-over-func loop:
+
+	l := New[string]()
+	for _, s := range []string{"the", "quick", "brown", "fox"} {
+		l.Append(l.Tail(), lnode.New[string](s))
+	}
+	for node := range l.All() {
+		fmt.Println(node.Value)
+	}
+	// Output:
+	// the
+	// quick
+	// brown
+	// fox
+
+Modifying the list (Append, Prepend, Delete, SetValue, FixHead, FixTail, FixCounts) while a range over
+All() or Backward() is in progress is not supported; it is detected on a best-effort basis and causes
+a panic, mirroring the fail-fast behavior of Java's iterators.
+*/
+func (l *LList[V]) All() iter.Seq[*lnode.Node[V]] {
+	return func(yield func(*lnode.Node[V]) bool) {
+		startMod := l.modCount
+		for n := l.Head(); n != nil; n = n.Next {
+			if l.modCount != startMod {
+				panic("llist: list modified during All() iteration")
+			}
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+/*
+Backward returns an iter.Seq over the nodes of the list, tail to head. See All() for the concurrent
+modification caveat.
+*/
+func (l *LList[V]) Backward() iter.Seq[*lnode.Node[V]] {
+	return func(yield func(*lnode.Node[V]) bool) {
+		startMod := l.modCount
+		for n := l.Tail(); n != nil; n = n.Prev {
+			if l.modCount != startMod {
+				panic("llist: list modified during Backward() iteration")
+			}
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+/*
+From returns an iter.Seq over the nodes of the list starting at (and including) node, heading towards
+the tail. node is typically obtained through Head(), FindNodes(), or a previous iteration. See All()
+for the concurrent modification caveat.
+*/
+func (l *LList[V]) From(node *lnode.Node[V]) iter.Seq[*lnode.Node[V]] {
+	return func(yield func(*lnode.Node[V]) bool) {
+		startMod := l.modCount
+		for n := node; n != nil; n = n.Next {
+			if l.modCount != startMod {
+				panic("llist: list modified during From() iteration")
+			}
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+/*
+FindAll returns an iter.Seq2 over (index, node) pairs for the nodes whose value satisfies predicate,
+walking the list head to tail. The index is the zero-based position of the node in the list, not the
+position among the matches. See All() for the concurrent modification caveat.
+
+Example:
+
+	l := New[int]()
+	for _, v := range []int{1, 2, 3, 4, 5, 6} {
+		l.Append(l.Tail(), lnode.New[int](v))
+	}
+	for i, n := range l.FindAll(func(v int) bool { return v%2 == 0 }) {
+		fmt.Println(i, n.Value)
+	}
+	// Output:
+	// 1 2
+	// 3 4
+	// 5 6
+*/
+func (l *LList[V]) FindAll(predicate func(V) bool) iter.Seq2[int, *lnode.Node[V]] {
+	return func(yield func(int, *lnode.Node[V]) bool) {
+		startMod := l.modCount
+		i := 0
+		for n := l.Head(); n != nil; n = n.Next {
+			if l.modCount != startMod {
+				panic("llist: list modified during FindAll() iteration")
+			}
+			if predicate(n.Value) {
+				if !yield(i, n) {
+					return
+				}
+			}
+			i++
+		}
+	}
+}
+
+/*
+Filter builds and returns a new LList containing copies of the nodes whose value satisfies predicate,
+in the same relative order. The new list shares no nodes with the receiver, so mutating one has no
+effect on the other.
+*/
+func (l *LList[V]) Filter(predicate func(V) bool) *LList[V] {
+	out := New[V]()
+	for n := range l.All() {
+		if predicate(n.Value) {
+			out.Append(out.Tail(), lnode.New(n.Value))
+		}
+	}
+	return out
+}
+
+/*
+Map applies fn to every value of l and returns a new list of the (possibly different) result type U,
+in the same relative order. Map is a package-level function rather than a method because Go methods
+cannot introduce additional type parameters.
+
+Example:
+
+	l := New[int]()
+	for _, v := range []int{1, 2, 3} {
+		l.Append(l.Tail(), lnode.New[int](v))
+	}
+	doubled := Map(l, func(v int) string { return fmt.Sprintf("%dx2=%d", v, v*2) })
+*/
+func Map[V comparable, U comparable](l *LList[V], fn func(V) U) *LList[U] {
+	out := New[U]()
+	for n := range l.All() {
+		out.Append(out.Tail(), lnode.New(fn(n.Value)))
+	}
+	return out
+}
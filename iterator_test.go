@@ -0,0 +1,99 @@
+package llist
+
+import (
+	"testing"
+
+	"github.com/KarelKubat/lnode"
+)
+
+func TestAll(t *testing.T) {
+	l := mkList()
+	want := 0
+	for n := range l.All() {
+		if n.Value != want {
+			t.Errorf("All(): got value %d, want %d", n.Value, want)
+		}
+		want++
+	}
+}
+
+func TestBackward(t *testing.T) {
+	l := mkList()
+	want := 9
+	for n := range l.Backward() {
+		if n.Value != want {
+			t.Errorf("Backward(): got value %d, want %d", n.Value, want)
+		}
+		want--
+	}
+}
+
+func TestFrom(t *testing.T) {
+	l := mkList()
+	start := l.Head().Next.Next // value 2
+	want := 2
+	for n := range l.From(start) {
+		if n.Value != want {
+			t.Errorf("From(): got value %d, want %d", n.Value, want)
+		}
+		want++
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	l := mkList()
+	var got []int
+	for _, n := range l.FindAll(func(v int) bool { return v%2 == 0 }) {
+		got = append(got, n.Value)
+	}
+	want := []int{0, 2, 4, 6, 8}
+	if len(got) != len(want) {
+		t.Fatalf("FindAll(): got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("FindAll(): got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestAllPanicsOnModification(t *testing.T) {
+	l := mkList()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("All(): expected a panic when mutating during iteration, got none")
+		}
+	}()
+	for range l.All() {
+		l.Append(l.Tail(), lnode.New[int](99))
+	}
+}
+
+func TestFilter(t *testing.T) {
+	l := mkList()
+	evens := l.Filter(func(v int) bool { return v%2 == 0 })
+	want := 0
+	for n := range evens.All() {
+		if n.Value != want {
+			t.Errorf("Filter(): got value %d, want %d", n.Value, want)
+		}
+		want += 2
+	}
+	// The filtered list must share no nodes with the source.
+	evens.Delete(evens.Head())
+	if _, ok := l.nodes[0]; !ok {
+		t.Errorf("Filter(): deleting from the filtered list affected the source list")
+	}
+}
+
+func TestMap(t *testing.T) {
+	l := mkList()
+	doubled := Map(l, func(v int) int { return v * 2 })
+	want := 0
+	for n := range doubled.All() {
+		if n.Value != want {
+			t.Errorf("Map(): got value %d, want %d", n.Value, want)
+		}
+		want += 2
+	}
+}
@@ -11,6 +11,16 @@ import (
 type LList[V comparable] struct {
 	head, tail *lnode.Node[V]         // Head/tail for fast lookup
 	nodes      map[V][]*lnode.Node[V] // Nodes keyed by value for lookup
+	size       int                    // Number of nodes currently in the list
+	modCount   uint64                 // Bumped on every mutation, used to detect concurrent modification while iterating (see All(), Backward())
+
+	// Order-statistics index, nil until EnableOrderIndex() is called (see treap.go).
+	indexRoot *treapNode[V]
+	indexMap  map[*lnode.Node[V]]*treapNode[V]
+
+	// capacity is 0 for an unbounded list, or the maximum size for one created with NewBounded
+	// (see merge.go), which evicts its tail (or its head, when the new node is the tail) once exceeded.
+	capacity int
 }
 
 /*
@@ -81,16 +91,23 @@ Append() and Tail() can be used to build a list from left to right:
 	fmt.Println(l.Tail().Value)  // "fox"
 */
 func (l *LList[V]) Append(anchor, n *lnode.Node[V]) {
-	if l.head == nil {
+	var pos int
+	switch {
+	case l.head == nil:
 		l.head = n
 		l.tail = n
-	} else if anchor == l.tail {
+		pos = 0
+	case anchor == l.tail:
+		pos = treapSize(l.indexRoot)
 		l.tail.Append(n)
 		l.tail = n
-	} else {
+	default:
+		pos = l.IndexOf(anchor) + 1
 		anchor.Append(n)
 	}
 	l.addCount(n)
+	l.indexInsert(pos, n)
+	l.enforceCapacity(n)
 }
 
 /*
@@ -108,17 +125,23 @@ Prepend() and Head() can be used to build a list from right to left:
 	fmt.Println(l.Tail().Value)  // "the"
 */
 func (l *LList[V]) Prepend(anchor, n *lnode.Node[V]) {
+	var pos int
 	switch {
 	case l.head == nil && l.tail == nil:
 		l.head = n
 		l.tail = n
+		pos = 0
 	case anchor == l.head:
+		pos = 0
 		l.head.Prepend(n)
 		l.head = n
 	default:
+		pos = l.IndexOf(anchor)
 		anchor.Prepend(n)
 	}
 	l.addCount(n)
+	l.indexInsert(pos, n)
+	l.enforceCapacity(n)
 }
 
 /*
@@ -147,6 +170,7 @@ Example:
 */
 func (l *LList[V]) FixHead() {
 	l.head = l.head.Head()
+	l.modCount++
 }
 
 /*
@@ -156,10 +180,11 @@ FixTail() doesn't work on circular lists.
 */
 func (l *LList[V]) FixTail() {
 	l.tail = l.tail.Tail()
+	l.modCount++
 }
 
 /*
-FixCounts recomputes the stored pointers to kept nodes. This may be necessary when the list is modified using llnode's functions instead of the corresponding llist functions.
+FixCounts recomputes the stored pointers to kept nodes, and the order index if EnableOrderIndex() was previously called. This may be necessary when the list is modified using llnode's functions instead of the corresponding llist functions.
 
 FixCounts() doesn't work on circular lists.
 */
@@ -169,6 +194,10 @@ func (l *LList[V]) FixCounts() {
 		return
 	}
 	l.nodes = map[V][]*lnode.Node[V]{}
+	l.size = 0
+	if l.indexMap != nil {
+		defer l.EnableOrderIndex()
+	}
 	hd.VisitByNext(func(node *lnode.Node[V]) bool {
 		l.addCount(node)
 		return true
@@ -229,6 +258,7 @@ func (l *LList[V]) Delete(node *lnode.Node[V]) {
 	}
 	node.Delete()
 	l.subCount(node)
+	l.indexDelete(node)
 }
 
 /*
@@ -255,6 +285,8 @@ func (l *LList[V]) addCount(n *lnode.Node[V]) {
 		l.nodes[n.Value] = []*lnode.Node[V]{}
 	}
 	l.nodes[n.Value] = append(l.nodes[n.Value], n)
+	l.size++
+	l.modCount++
 }
 
 // Helper
@@ -269,5 +301,9 @@ func (l *LList[V]) subCount(n *lnode.Node[V]) {
 			newNds = append(newNds, nd)
 		}
 	}
+	if len(newNds) < len(nds) {
+		l.size--
+	}
 	l.nodes[n.Value] = newNds
+	l.modCount++
 }
@@ -0,0 +1,208 @@
+package llist
+
+import (
+	"github.com/KarelKubat/lnode"
+)
+
+/*
+NewBounded constructs an LList receiver with a fixed capacity: once Append or Prepend would grow the
+list past capacity nodes, the current tail is evicted, or the head when the node just added is itself
+the tail (see enforceCapacity). Combined with MoveToFront/MoveToBack, this is
+the classic doubly-linked-list-plus-map pattern behind an LRU cache — Prepend (or MoveToFront) the
+most recently used node, and let NewBounded evict the least recently used one from the tail.
+
+Example:
+
+	cache := NewBounded[string](2)
+	cache.Prepend(cache.Head(), lnode.New[string]("a"))
+	cache.Prepend(cache.Head(), lnode.New[string]("b"))
+	cache.Prepend(cache.Head(), lnode.New[string]("c")) // evicts "a"
+*/
+func NewBounded[V comparable](capacity int) *LList[V] {
+	l := New[V]()
+	l.capacity = capacity
+	return l
+}
+
+/*
+enforceCapacity evicts nodes until the list is back within its capacity, without ever evicting just,
+the node that was just inserted (n). It is a no-op for unbounded lists (capacity 0, the default from
+New()).
+
+Growing the list via Prepend puts the newest node at the head, so the oldest node — the one to evict
+— is the tail; growing via Append puts the newest node at the tail, so the oldest node is the head.
+Evicting unconditionally from the tail, as if every caller used Prepend, would instead delete the
+node Append() just inserted once the list is grown from that end.
+*/
+func (l *LList[V]) enforceCapacity(n *lnode.Node[V]) {
+	for l.capacity > 0 && l.size > l.capacity {
+		victim := l.tail
+		if victim == n {
+			victim = l.head
+		}
+		l.Delete(victim)
+	}
+}
+
+/*
+Reverse reverses the list in place, in O(n) time: what was the head becomes the tail and vice versa.
+The per-value nodes index doesn't need rebuilding, since reversing doesn't change which nodes exist,
+only their order; the order index (if EnableOrderIndex() was called) does, since positions change,
+and is rebuilt automatically.
+*/
+func (l *LList[V]) Reverse() {
+	for n := l.head; n != nil; {
+		next := n.Next
+		n.Next, n.Prev = n.Prev, next
+		n = next
+	}
+	l.head, l.tail = l.tail, l.head
+	l.modCount++
+	if l.indexMap != nil {
+		l.EnableOrderIndex()
+	}
+}
+
+/*
+Split splits the list at node, which becomes the head of the second of the two returned lists; the
+first list holds everything that came before node. The receiver is left empty, as if newly
+constructed by New(), since its nodes have moved into the two results. Split runs in O(n) time,
+dominated by rebuilding the per-value nodes index of each half from the (unchanged) nodes that end
+up in it, rather than by copying or re-creating any node. If EnableOrderIndex() had been called on
+the receiver, the order index is rebuilt on both halves too.
+*/
+func (l *LList[V]) Split(node *lnode.Node[V]) (*LList[V], *LList[V]) {
+	left := New[V]()
+	right := New[V]()
+	if node == nil {
+		return left, right
+	}
+
+	hadIndex := l.indexMap != nil
+
+	prev := node.Prev
+	node.Prev = nil
+	if prev == nil {
+		right.head, right.tail = l.head, l.tail
+	} else {
+		prev.Next = nil
+		left.head, left.tail = l.head, prev
+		right.head, right.tail = node, l.tail
+	}
+
+	for n := left.head; n != nil; n = n.Next {
+		left.addCount(n)
+	}
+	for n := right.head; n != nil; n = n.Next {
+		right.addCount(n)
+	}
+
+	if hadIndex {
+		left.EnableOrderIndex()
+		right.EnableOrderIndex()
+	}
+
+	*l = *New[V]()
+	return left, right
+}
+
+/*
+Concat splices b after a, in O(1) time for relinking the chain plus the time needed to merge their
+per-value nodes indices, and returns the result as a new list. Both a and b are left empty, as if
+newly constructed by New(), since their nodes (and index) have moved into the result. If
+EnableOrderIndex() had been called on either a or b, the order index is rebuilt on the result too.
+*/
+func Concat[V comparable](a, b *LList[V]) *LList[V] {
+	out := New[V]()
+	hadIndex := a.indexMap != nil || b.indexMap != nil
+	switch {
+	case a.head == nil:
+		out.head, out.tail = b.head, b.tail
+	case b.head == nil:
+		out.head, out.tail = a.head, a.tail
+	default:
+		a.tail.Next = b.head
+		b.head.Prev = a.tail
+		out.head, out.tail = a.head, b.tail
+	}
+
+	out.nodes = a.nodes
+	for v, nds := range b.nodes {
+		out.nodes[v] = append(out.nodes[v], nds...)
+	}
+	out.size = a.size + b.size
+
+	*a = *New[V]()
+	*b = *New[V]()
+
+	if hadIndex {
+		out.EnableOrderIndex()
+	}
+	return out
+}
+
+/*
+MoveToFront moves node to the head of the list in O(1) time. If node is already the head, this is a
+no-op. If an order index is enabled (see EnableOrderIndex), it is rebuilt, since moving a node
+changes the positions of every node between its old and new location.
+*/
+func (l *LList[V]) MoveToFront(node *lnode.Node[V]) {
+	if node == l.head {
+		return
+	}
+	l.unlink(node)
+	node.Prev = nil
+	node.Next = l.head
+	if l.head != nil {
+		l.head.Prev = node
+	}
+	l.head = node
+	if l.tail == nil {
+		l.tail = node
+	}
+	l.modCount++
+	if l.indexMap != nil {
+		l.EnableOrderIndex()
+	}
+}
+
+/*
+MoveToBack moves node to the tail of the list in O(1) time. If node is already the tail, this is a
+no-op. See MoveToFront for the order-index caveat.
+*/
+func (l *LList[V]) MoveToBack(node *lnode.Node[V]) {
+	if node == l.tail {
+		return
+	}
+	l.unlink(node)
+	node.Next = nil
+	node.Prev = l.tail
+	if l.tail != nil {
+		l.tail.Next = node
+	}
+	l.tail = node
+	if l.head == nil {
+		l.head = node
+	}
+	l.modCount++
+	if l.indexMap != nil {
+		l.EnableOrderIndex()
+	}
+}
+
+// unlink removes node from its current position in the chain, moving head/tail off node if it was at
+// either end, without touching node's own Next/Prev, which the caller is about to overwrite.
+func (l *LList[V]) unlink(node *lnode.Node[V]) {
+	if node == l.head {
+		l.head = node.Next
+	}
+	if node == l.tail {
+		l.tail = node.Prev
+	}
+	if node.Prev != nil {
+		node.Prev.Next = node.Next
+	}
+	if node.Next != nil {
+		node.Next.Prev = node.Prev
+	}
+}
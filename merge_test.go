@@ -0,0 +1,207 @@
+package llist
+
+import (
+	"testing"
+
+	"github.com/KarelKubat/lnode"
+)
+
+func TestReverse(t *testing.T) {
+	l := mkList()
+	l.Reverse()
+
+	want := 9
+	for n := range l.All() {
+		if n.Value != want {
+			t.Errorf("Reverse(): got value %d, want %d", n.Value, want)
+		}
+		want--
+	}
+	if l.Head().Value != 9 || l.Tail().Value != 0 {
+		t.Errorf("Reverse(): head/tail = %d/%d, want 9/0", l.Head().Value, l.Tail().Value)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	l := mkList()
+	five := l.FindNodes(5)[0]
+
+	left, right := l.Split(five)
+
+	wantLeft := []int{0, 1, 2, 3, 4}
+	i := 0
+	for n := range left.All() {
+		if n.Value != wantLeft[i] {
+			t.Errorf("Split(): left[%d] = %d, want %d", i, n.Value, wantLeft[i])
+		}
+		i++
+	}
+	if i != len(wantLeft) {
+		t.Errorf("Split(): left has %d elements, want %d", i, len(wantLeft))
+	}
+
+	wantRight := []int{5, 6, 7, 8, 9}
+	i = 0
+	for n := range right.All() {
+		if n.Value != wantRight[i] {
+			t.Errorf("Split(): right[%d] = %d, want %d", i, n.Value, wantRight[i])
+		}
+		i++
+	}
+	if i != len(wantRight) {
+		t.Errorf("Split(): right has %d elements, want %d", i, len(wantRight))
+	}
+
+	if nds := right.FindNodes(5); len(nds) != 1 {
+		t.Errorf("Split(): right.FindNodes(5) = %d, want 1", len(nds))
+	}
+	if nds := left.FindNodes(5); len(nds) != 0 {
+		t.Errorf("Split(): left.FindNodes(5) = %d, want 0", len(nds))
+	}
+	if l.Head() != nil {
+		t.Errorf("Split(): receiver not left empty, head = %v", l.Head())
+	}
+}
+
+func TestSplitPreservesOrderIndex(t *testing.T) {
+	l := mkList()
+	l.EnableOrderIndex()
+	five := l.FindNodes(5)[0]
+
+	left, right := l.Split(five)
+
+	for i, want := range []int{0, 1, 2, 3, 4} {
+		if n := left.At(i); n == nil || n.Value != want {
+			t.Errorf("Split(): left.At(%d) = %v, want %d", i, n, want)
+		}
+	}
+	for i, want := range []int{5, 6, 7, 8, 9} {
+		if n := right.At(i); n == nil || n.Value != want {
+			t.Errorf("Split(): right.At(%d) = %v, want %d", i, n, want)
+		}
+	}
+	if idx := right.IndexOf(five); idx != 0 {
+		t.Errorf("Split(): right.IndexOf(five) = %d, want 0", idx)
+	}
+}
+
+func TestConcat(t *testing.T) {
+	a := New[int]()
+	for _, v := range []int{1, 2, 3} {
+		a.Append(a.Tail(), lnode.New[int](v))
+	}
+	b := New[int]()
+	for _, v := range []int{4, 5, 6} {
+		b.Append(b.Tail(), lnode.New[int](v))
+	}
+
+	out := Concat(a, b)
+	want := 1
+	for n := range out.All() {
+		if n.Value != want {
+			t.Errorf("Concat(): got value %d, want %d", n.Value, want)
+		}
+		want++
+	}
+	if nds := out.FindNodes(5); len(nds) != 1 {
+		t.Errorf("Concat(): FindNodes(5) = %d, want 1", len(nds))
+	}
+	if a.Head() != nil || b.Head() != nil {
+		t.Errorf("Concat(): inputs not left empty")
+	}
+}
+
+func TestConcatPreservesOrderIndex(t *testing.T) {
+	a := New[int]()
+	for _, v := range []int{1, 2, 3} {
+		a.Append(a.Tail(), lnode.New[int](v))
+	}
+	a.EnableOrderIndex() // enabling on just one of the two inputs must still carry over
+
+	b := New[int]()
+	for _, v := range []int{4, 5, 6} {
+		b.Append(b.Tail(), lnode.New[int](v))
+	}
+
+	out := Concat(a, b)
+	for i, want := range []int{1, 2, 3, 4, 5, 6} {
+		if n := out.At(i); n == nil || n.Value != want {
+			t.Errorf("Concat(): At(%d) = %v, want %d", i, n, want)
+		}
+	}
+	if idx := out.IndexOf(out.FindNodes(5)[0]); idx != 4 {
+		t.Errorf("Concat(): IndexOf(5) = %d, want 4", idx)
+	}
+}
+
+func TestMoveToFrontAndBack(t *testing.T) {
+	l := mkList()
+	five := l.FindNodes(5)[0]
+
+	l.MoveToFront(five)
+	if l.Head().Value != 5 {
+		t.Errorf("MoveToFront(5): head = %d, want 5", l.Head().Value)
+	}
+
+	l.MoveToBack(five)
+	if l.Tail().Value != 5 {
+		t.Errorf("MoveToBack(5): tail = %d, want 5", l.Tail().Value)
+	}
+
+	// No-ops when already at the target end.
+	l.MoveToBack(l.Tail())
+	if l.Tail().Value != 5 {
+		t.Errorf("MoveToBack(tail): tail changed to %d", l.Tail().Value)
+	}
+}
+
+func TestNewBoundedEvicts(t *testing.T) {
+	l := NewBounded[string](2)
+	l.Prepend(l.Head(), lnode.New[string]("a"))
+	l.Prepend(l.Head(), lnode.New[string]("b"))
+	l.Prepend(l.Head(), lnode.New[string]("c"))
+
+	var got []string
+	for n := range l.All() {
+		got = append(got, n.Value)
+	}
+	want := []string{"c", "b"}
+	if len(got) != len(want) {
+		t.Fatalf("NewBounded: got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("NewBounded: got %v, want %v", got, want)
+		}
+	}
+	if nds := l.FindNodes("a"); len(nds) != 0 {
+		t.Errorf("NewBounded: FindNodes(a) after eviction = %d, want 0", len(nds))
+	}
+}
+
+func TestNewBoundedEvictsOnAppend(t *testing.T) {
+	l := NewBounded[string](2)
+	l.Append(l.Tail(), lnode.New[string]("a"))
+	l.Append(l.Tail(), lnode.New[string]("b"))
+	l.Append(l.Tail(), lnode.New[string]("c"))
+
+	var got []string
+	for n := range l.All() {
+		got = append(got, n.Value)
+	}
+	want := []string{"b", "c"}
+	if len(got) != len(want) {
+		t.Fatalf("NewBounded/Append: got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("NewBounded/Append: got %v, want %v", got, want)
+		}
+	}
+	if nds := l.FindNodes("a"); len(nds) != 0 {
+		t.Errorf("NewBounded/Append: FindNodes(a) after eviction = %d, want 0", len(nds))
+	}
+	if l.Tail().Value != "c" {
+		t.Errorf("NewBounded/Append: tail = %q, want %q", l.Tail().Value, "c")
+	}
+}
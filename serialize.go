@@ -0,0 +1,317 @@
+package llist
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/KarelKubat/lnode"
+)
+
+/*
+MarshalJSON implements json.Marshaler. The list is encoded as a JSON array of its values, head to
+tail; the internal head/tail pointers and nodes index are not part of the wire format and are
+rebuilt on UnmarshalJSON.
+*/
+func (l *LList[V]) MarshalJSON() ([]byte, error) {
+	values := []V{}
+	for n := range l.All() {
+		values = append(values, n.Value)
+	}
+	return json.Marshal(values)
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. It replaces the receiver's contents with the decoded
+values, rebuilding the nodes index (and the order index, if EnableOrderIndex() had been called) so
+that FindNodes, At etc. work immediately afterwards.
+
+Example:
+
+	l := New[string]()
+	if err := json.Unmarshal(data, l); err != nil {
+		// handle err
+	}
+*/
+func (l *LList[V]) UnmarshalJSON(data []byte) error {
+	var values []V
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	l.reset()
+	for _, v := range values {
+		l.Append(l.Tail(), lnode.New(v))
+	}
+	return nil
+}
+
+/*
+GobEncode implements gob.GobEncoder, encoding the list's values, head to tail, the same way
+MarshalJSON does for JSON.
+*/
+func (l *LList[V]) GobEncode() ([]byte, error) {
+	values := []V{}
+	for n := range l.All() {
+		values = append(values, n.Value)
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(values); err != nil {
+		return nil, fmt.Errorf("llist: GobEncode: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder, the counterpart to GobEncode.
+func (l *LList[V]) GobDecode(data []byte) error {
+	var values []V
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return fmt.Errorf("llist: GobDecode: %w", err)
+	}
+	l.reset()
+	for _, v := range values {
+		l.Append(l.Tail(), lnode.New(v))
+	}
+	return nil
+}
+
+/*
+Codec encodes and decodes a single value of type V to and from a binary stream, for use with
+WriteCodec and ReadCodec. V is only constrained to be comparable, so llist cannot pick an encoding
+for it on its own; built-in codecs are provided below for the common primitives (IntCodec,
+Int64Codec, Float64Codec, BoolCodec, StringCodec) and callers can implement Codec themselves for
+anything else.
+*/
+type Codec[V comparable] interface {
+	Encode(v V, w io.Writer) error
+	Decode(r io.Reader) (V, error)
+}
+
+// binaryMagic and binaryVersion identify the framed format written by WriteCodec.
+var binaryMagic = [4]byte{'L', 'L', 'S', 'T'}
+
+const binaryVersion = 1
+
+/*
+WriteCodec writes the list to w in a compact, framed binary format: 4 magic bytes, a version byte, a
+varint-encoded element count, and then each element's payload written by codec.Encode, in head-to-
+tail order. It returns the number of bytes written.
+
+It is named WriteCodec rather than WriteTo because it takes a Codec[V] in addition to the
+io.Writer, so it can't implement the stdlib io.WriterTo interface (whose WriteTo takes only an
+io.Writer).
+*/
+func (l *LList[V]) WriteCodec(w io.Writer, codec Codec[V]) (int64, error) {
+	counted := &countingWriter{w: w}
+
+	if _, err := counted.Write(binaryMagic[:]); err != nil {
+		return counted.n, fmt.Errorf("llist: WriteCodec: writing magic: %w", err)
+	}
+	if _, err := counted.Write([]byte{binaryVersion}); err != nil {
+		return counted.n, fmt.Errorf("llist: WriteCodec: writing version: %w", err)
+	}
+
+	var countBuf [binary.MaxVarintLen64]byte
+	nb := binary.PutUvarint(countBuf[:], uint64(l.size))
+	if _, err := counted.Write(countBuf[:nb]); err != nil {
+		return counted.n, fmt.Errorf("llist: WriteCodec: writing count: %w", err)
+	}
+
+	for node := range l.All() {
+		if err := codec.Encode(node.Value, counted); err != nil {
+			return counted.n, fmt.Errorf("llist: WriteCodec: encoding element: %w", err)
+		}
+	}
+	return counted.n, nil
+}
+
+/*
+ReadCodec reads a list previously written by WriteCodec from r, replacing the receiver's contents
+(and rebuilding its nodes index, and order index if one was enabled). It returns the number of bytes
+read. ReadCodec fails if the magic bytes or version don't match, or if decoding yields a different
+element count than the header announced, which would otherwise risk building a list whose nodes
+index doesn't match its contents.
+
+It is named ReadCodec rather than ReadFrom for the same reason WriteCodec isn't named WriteTo: the
+extra Codec[V] parameter rules out the stdlib io.ReaderFrom shape.
+*/
+func (l *LList[V]) ReadCodec(r io.Reader, codec Codec[V]) (int64, error) {
+	counted := &countingReader{r: r}
+
+	var magic [4]byte
+	if _, err := io.ReadFull(counted, magic[:]); err != nil {
+		return counted.n, fmt.Errorf("llist: ReadCodec: reading magic: %w", err)
+	}
+	if magic != binaryMagic {
+		return counted.n, fmt.Errorf("llist: ReadCodec: bad magic %q, want %q", magic, binaryMagic)
+	}
+
+	var versionBuf [1]byte
+	if _, err := io.ReadFull(counted, versionBuf[:]); err != nil {
+		return counted.n, fmt.Errorf("llist: ReadCodec: reading version: %w", err)
+	}
+	if versionBuf[0] != binaryVersion {
+		return counted.n, fmt.Errorf("llist: ReadCodec: unsupported version %d", versionBuf[0])
+	}
+
+	count, err := binary.ReadUvarint(counted)
+	if err != nil {
+		return counted.n, fmt.Errorf("llist: ReadCodec: reading count: %w", err)
+	}
+
+	l.reset()
+	for i := uint64(0); i < count; i++ {
+		v, err := codec.Decode(counted)
+		if err != nil {
+			return counted.n, fmt.Errorf("llist: ReadCodec: decoding element %d: %w", i, err)
+		}
+		l.Append(l.Tail(), lnode.New(v))
+	}
+	// By construction every element was appended exactly once in order, so the result cannot be
+	// circular. The count can still fall short when the receiver was created by NewBounded, whose
+	// capacity evicts nodes during the appends. A Codec that reads too few bytes without raising an
+	// error is not detected here.
+	if uint64(l.size) != count {
+		return counted.n, fmt.Errorf("llist: ReadCodec: decoded %d elements, header announced %d", l.size, count)
+	}
+	return counted.n, nil
+}
+
+// reset clears the receiver back to an empty list, preserving whether an order index was enabled.
+func (l *LList[V]) reset() {
+	hadIndex := l.indexMap != nil
+	l.head = nil
+	l.tail = nil
+	l.nodes = map[V][]*lnode.Node[V]{}
+	l.size = 0
+	l.indexRoot = nil
+	l.indexMap = nil
+	l.modCount++
+	if hadIndex {
+		l.indexMap = map[*lnode.Node[V]]*treapNode[V]{}
+	}
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *countingReader) ReadByte() (byte, error) {
+	var b [1]byte
+	_, err := io.ReadFull(c.r, b[:])
+	if err != nil {
+		return 0, err
+	}
+	c.n++
+	return b[0], nil
+}
+
+// IntCodec encodes/decodes int values as fixed-width 8-byte big-endian integers.
+type IntCodec struct{}
+
+func (IntCodec) Encode(v int, w io.Writer) error {
+	return binary.Write(w, binary.BigEndian, int64(v))
+}
+
+func (IntCodec) Decode(r io.Reader) (int, error) {
+	var v int64
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return 0, err
+	}
+	return int(v), nil
+}
+
+// Int64Codec encodes/decodes int64 values as fixed-width 8-byte big-endian integers.
+type Int64Codec struct{}
+
+func (Int64Codec) Encode(v int64, w io.Writer) error {
+	return binary.Write(w, binary.BigEndian, v)
+}
+
+func (Int64Codec) Decode(r io.Reader) (int64, error) {
+	var v int64
+	err := binary.Read(r, binary.BigEndian, &v)
+	return v, err
+}
+
+// Float64Codec encodes/decodes float64 values as fixed-width 8-byte IEEE 754 big-endian floats.
+type Float64Codec struct{}
+
+func (Float64Codec) Encode(v float64, w io.Writer) error {
+	return binary.Write(w, binary.BigEndian, v)
+}
+
+func (Float64Codec) Decode(r io.Reader) (float64, error) {
+	var v float64
+	err := binary.Read(r, binary.BigEndian, &v)
+	return v, err
+}
+
+// BoolCodec encodes/decodes bool values as a single byte, 1 for true and 0 for false.
+type BoolCodec struct{}
+
+func (BoolCodec) Encode(v bool, w io.Writer) error {
+	b := byte(0)
+	if v {
+		b = 1
+	}
+	_, err := w.Write([]byte{b})
+	return err
+}
+
+func (BoolCodec) Decode(r io.Reader) (bool, error) {
+	var b [1]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return false, err
+	}
+	return b[0] != 0, nil
+}
+
+// StringCodec encodes/decodes string values as a varint length followed by the raw UTF-8 bytes.
+type StringCodec struct{}
+
+func (StringCodec) Encode(v string, w io.Writer) error {
+	var lenBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBuf[:], uint64(len(v)))
+	if _, err := w.Write(lenBuf[:n]); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, v)
+	return err
+}
+
+func (StringCodec) Decode(r io.Reader) (string, error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = &countingReader{r: r}
+	}
+	length, err := binary.ReadUvarint(br)
+	if err != nil {
+		return "", err
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
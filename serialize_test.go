@@ -0,0 +1,138 @@
+package llist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/KarelKubat/lnode"
+)
+
+func mkStringList() *LList[string] {
+	l := New[string]()
+	for _, s := range []string{"the", "quick", "brown", "fox"} {
+		l.Append(l.Tail(), lnode.New[string](s))
+	}
+	return l
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	l := mkStringList()
+	data, err := json.Marshal(l)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	got := New[string]()
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	want := 0
+	for n := range got.All() {
+		if n.Value != mustIndex(l, want) {
+			t.Errorf("value at %d = %q, want %q", want, n.Value, mustIndex(l, want))
+		}
+		want++
+	}
+	if nds := got.FindNodes("brown"); len(nds) != 1 {
+		t.Errorf("FindNodes(brown) after Unmarshal = %d, want 1", len(nds))
+	}
+}
+
+func mustIndex(l *LList[string], i int) string {
+	j := 0
+	for n := range l.All() {
+		if j == i {
+			return n.Value
+		}
+		j++
+	}
+	return ""
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	l := mkList() // []int 0..9
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(l); err != nil {
+		t.Fatalf("gob Encode: %v", err)
+	}
+
+	got := New[int]()
+	if err := gob.NewDecoder(&buf).Decode(got); err != nil {
+		t.Fatalf("gob Decode: %v", err)
+	}
+
+	want := 0
+	for n := range got.All() {
+		if n.Value != want {
+			t.Errorf("got value %d, want %d", n.Value, want)
+		}
+		want++
+	}
+	if nds := got.FindNodes(5); len(nds) != 1 {
+		t.Errorf("FindNodes(5) after gob round trip = %d, want 1", len(nds))
+	}
+}
+
+func TestWriteCodecReadCodec(t *testing.T) {
+	l := mkList()
+
+	var buf bytes.Buffer
+	written, err := l.WriteCodec(&buf, IntCodec{})
+	if err != nil {
+		t.Fatalf("WriteCodec: %v", err)
+	}
+	if written == 0 {
+		t.Fatalf("WriteCodec: wrote 0 bytes")
+	}
+
+	got := New[int]()
+	read, err := got.ReadCodec(&buf, IntCodec{})
+	if err != nil {
+		t.Fatalf("ReadCodec: %v", err)
+	}
+	if read != written {
+		t.Errorf("ReadCodec read %d bytes, WriteCodec wrote %d", read, written)
+	}
+
+	want := 0
+	for n := range got.All() {
+		if n.Value != want {
+			t.Errorf("got value %d, want %d", n.Value, want)
+		}
+		want++
+	}
+}
+
+func TestWriteCodecReadCodecStrings(t *testing.T) {
+	l := mkStringList()
+
+	var buf bytes.Buffer
+	if _, err := l.WriteCodec(&buf, StringCodec{}); err != nil {
+		t.Fatalf("WriteCodec: %v", err)
+	}
+
+	got := New[string]()
+	if _, err := got.ReadCodec(&buf, StringCodec{}); err != nil {
+		t.Fatalf("ReadCodec: %v", err)
+	}
+
+	want := []string{"the", "quick", "brown", "fox"}
+	i := 0
+	for n := range got.All() {
+		if n.Value != want[i] {
+			t.Errorf("got value %q, want %q", n.Value, want[i])
+		}
+		i++
+	}
+}
+
+func TestReadCodecBadMagic(t *testing.T) {
+	got := New[int]()
+	if _, err := got.ReadCodec(bytes.NewReader([]byte("not-a-valid-header")), IntCodec{}); err == nil {
+		t.Errorf("ReadCodec with bad magic: want error, got nil")
+	}
+}
@@ -0,0 +1,173 @@
+package llist
+
+import (
+	"sync"
+
+	"github.com/KarelKubat/lnode"
+)
+
+/*
+SyncLList wraps an LList with a sync.RWMutex so that it can be shared safely between goroutines.
+Mutating methods (Append, Prepend, Delete, SetValue, FixHead, FixTail, FixCounts) take the write lock;
+read methods (Head, Tail, FindNodes) take the read lock.
+
+Without SyncLList, concurrent modification of an LList corrupts the internal nodes map and the
+head/tail pointers, since neither is protected on its own.
+
+Note that Append and Prepend each take the lock only for the one call: a sequence like
+s.Append(s.Tail(), n) is two separate locked operations, so under concurrent use the anchor returned
+by Tail() can be stale by the time Append() runs, leaving the structure intact but the insertion
+order nondeterministic. Use AppendTail/PrependHead below when "add this value at the current
+end" must be atomic; fall back to WithLock for anything more elaborate.
+
+Example:
+
+	l := NewSync[string]()
+	var wg sync.WaitGroup
+	for _, s := range []string{"the", "quick", "brown", "fox"} {
+		wg.Add(1)
+		go func(s string) {
+			defer wg.Done()
+			l.AppendTail(lnode.New[string](s))
+		}(s)
+	}
+	wg.Wait()
+	fmt.Println(l.Snapshot())
+*/
+type SyncLList[V comparable] struct {
+	mu sync.RWMutex
+	l  *LList[V]
+}
+
+// NewSync constructs a SyncLList receiver, ready for concurrent use.
+func NewSync[V comparable]() *SyncLList[V] {
+	return &SyncLList[V]{
+		l: New[V](),
+	}
+}
+
+// Head returns the head of the underlying list. See LList.Head().
+func (s *SyncLList[V]) Head() *lnode.Node[V] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.Head()
+}
+
+// Tail returns the tail of the underlying list. See LList.Tail().
+func (s *SyncLList[V]) Tail() *lnode.Node[V] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.Tail()
+}
+
+// FindNodes returns the nodes matching the stated value. See LList.FindNodes().
+func (s *SyncLList[V]) FindNodes(v V) []*lnode.Node[V] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.FindNodes(v)
+}
+
+// Append inserts n after anchor. See LList.Append(). A nil anchor, as returned by Tail() while the
+// list was still empty, is taken to mean the current tail.
+func (s *SyncLList[V]) Append(anchor, n *lnode.Node[V]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if anchor == nil {
+		anchor = s.l.Tail()
+	}
+	s.l.Append(anchor, n)
+}
+
+// Prepend inserts n before anchor. See LList.Prepend(). A nil anchor, as returned by Head() while the
+// list was still empty, is taken to mean the current head.
+func (s *SyncLList[V]) Prepend(anchor, n *lnode.Node[V]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if anchor == nil {
+		anchor = s.l.Head()
+	}
+	s.l.Prepend(anchor, n)
+}
+
+// AppendTail appends n after the current tail, holding the write lock across both the tail lookup and
+// the insertion so that, unlike s.Append(s.Tail(), n), the two steps are atomic with respect to other
+// goroutines.
+func (s *SyncLList[V]) AppendTail(n *lnode.Node[V]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.Append(s.l.Tail(), n)
+}
+
+// PrependHead prepends n before the current head, holding the write lock across both the head lookup
+// and the insertion. See AppendTail for why this differs from s.Prepend(s.Head(), n).
+func (s *SyncLList[V]) PrependHead(n *lnode.Node[V]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.Prepend(s.l.Head(), n)
+}
+
+// Delete removes node from the list. See LList.Delete().
+func (s *SyncLList[V]) Delete(node *lnode.Node[V]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.Delete(node)
+}
+
+// SetValue changes the value of node. See LList.SetValue().
+func (s *SyncLList[V]) SetValue(node *lnode.Node[V], value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.SetValue(node, value)
+}
+
+// FixHead recomputes the stored head. See LList.FixHead().
+func (s *SyncLList[V]) FixHead() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.FixHead()
+}
+
+// FixTail recomputes the stored tail. See LList.FixTail().
+func (s *SyncLList[V]) FixTail() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.FixTail()
+}
+
+// FixCounts recomputes the internal nodes index. See LList.FixCounts().
+func (s *SyncLList[V]) FixCounts() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.FixCounts()
+}
+
+/*
+Snapshot returns a consistent point-in-time copy of the list's values, head to tail. Unlike ranging
+over Head().VisitByNext() directly, Snapshot() holds the read lock for its whole duration, so the
+result cannot be torn by a concurrent writer.
+*/
+func (s *SyncLList[V]) Snapshot() []V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var out []V
+	hd := s.l.Head()
+	if hd == nil {
+		return out
+	}
+	hd.VisitByNext(func(n *lnode.Node[V]) bool {
+		out = append(out, n.Value)
+		return true
+	})
+	return out
+}
+
+/*
+WithLock runs fn with the write lock held, giving exclusive access to the underlying *LList for
+compound operations (e.g. a Find followed by a Delete) that must appear atomic to other goroutines.
+fn must not call back into s, or it will deadlock.
+*/
+func (s *SyncLList[V]) WithLock(fn func(*LList[V])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.l)
+}
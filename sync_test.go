@@ -0,0 +1,81 @@
+package llist
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/KarelKubat/lnode"
+)
+
+func TestSyncAppendConcurrent(t *testing.T) {
+	s := NewSync[int]()
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s.AppendTail(lnode.New[int](i))
+		}(i)
+	}
+	wg.Wait()
+
+	if got := len(s.Snapshot()); got != 100 {
+		t.Errorf("Snapshot(): got %d values, want 100", got)
+	}
+}
+
+// TestSyncAppendRacyPattern documents that s.Append(s.Tail(), n) is NOT atomic: the tail lookup and
+// the append are two separate locked operations, so concurrent callers can still race between them.
+// No data is lost or corrupted (the final length is always right), but it demonstrates why
+// AppendTail exists instead of expecting callers to compose Append with Tail themselves.
+func TestSyncAppendRacyPattern(t *testing.T) {
+	s := NewSync[int]()
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s.Append(s.Tail(), lnode.New[int](i))
+		}(i)
+	}
+	wg.Wait()
+
+	if got := len(s.Snapshot()); got != 100 {
+		t.Errorf("Snapshot(): got %d values, want 100", got)
+	}
+}
+
+func TestSyncFindNodesAndDelete(t *testing.T) {
+	s := NewSync[string]()
+	for _, v := range []string{"the", "quick", "brown", "fox"} {
+		s.Append(s.Tail(), lnode.New[string](v))
+	}
+
+	nds := s.FindNodes("brown")
+	if len(nds) != 1 {
+		t.Fatalf("FindNodes(brown): got %d, want 1", len(nds))
+	}
+	s.Delete(nds[0])
+	if len(s.FindNodes("brown")) != 0 {
+		t.Errorf("FindNodes(brown) after delete: want 0 matches")
+	}
+}
+
+func TestSyncWithLock(t *testing.T) {
+	s := NewSync[int]()
+	for i := range 5 {
+		s.Append(s.Tail(), lnode.New[int](i))
+	}
+
+	var headValue int
+	s.WithLock(func(l *LList[int]) {
+		headValue = l.Head().Value
+		l.Append(l.Tail(), lnode.New[int](5))
+	})
+	if headValue != 0 {
+		t.Errorf("WithLock(): got head value %d, want 0", headValue)
+	}
+	if len(s.Snapshot()) != 6 {
+		t.Errorf("WithLock(): Append inside WithLock not reflected, got %d values, want 6", len(s.Snapshot()))
+	}
+}
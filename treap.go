@@ -0,0 +1,225 @@
+package llist
+
+import (
+	"iter"
+	"math/rand/v2"
+
+	"github.com/KarelKubat/lnode"
+)
+
+/*
+treapNode is one node of the size-augmented treap that backs the optional order-statistics index
+(see EnableOrderIndex). Positions are implicit: the in-order rank of a treapNode is its position in
+the list, so no explicit index is stored on the node itself — it is derived on demand by walking
+parent pointers in IndexOf, or by descending on subtree sizes in At.
+*/
+type treapNode[V comparable] struct {
+	node                *lnode.Node[V]
+	left, right, parent *treapNode[V]
+	priority            uint64
+	size                int
+}
+
+func treapSize[V comparable](t *treapNode[V]) int {
+	if t == nil {
+		return 0
+	}
+	return t.size
+}
+
+// treapUpdate recomputes t.size from its children and re-parents them. Call after any rewiring of
+// t.left/t.right.
+func treapUpdate[V comparable](t *treapNode[V]) {
+	if t == nil {
+		return
+	}
+	if t.left != nil {
+		t.left.parent = t
+	}
+	if t.right != nil {
+		t.right.parent = t
+	}
+	t.size = 1 + treapSize(t.left) + treapSize(t.right)
+	t.parent = nil
+}
+
+// treapMerge joins two treaps, l entirely to the left of r, preserving heap order on priority.
+func treapMerge[V comparable](l, r *treapNode[V]) *treapNode[V] {
+	switch {
+	case l == nil:
+		return r
+	case r == nil:
+		return l
+	case l.priority > r.priority:
+		l.right = treapMerge(l.right, r)
+		treapUpdate(l)
+		return l
+	default:
+		r.left = treapMerge(l, r.left)
+		treapUpdate(r)
+		return r
+	}
+}
+
+// treapSplit splits t so that the returned left treap holds the first k nodes (by in-order
+// position) and right holds the rest.
+func treapSplit[V comparable](t *treapNode[V], k int) (left, right *treapNode[V]) {
+	if t == nil {
+		return nil, nil
+	}
+	leftSize := treapSize(t.left)
+	if k <= leftSize {
+		l, r := treapSplit(t.left, k)
+		t.left = r
+		treapUpdate(t)
+		return l, t
+	}
+	l, r := treapSplit(t.right, k-leftSize-1)
+	t.right = l
+	treapUpdate(t)
+	return t, r
+}
+
+// treapInsertAt inserts tn so that it becomes the k-th node (0-based) of root.
+func treapInsertAt[V comparable](root *treapNode[V], k int, tn *treapNode[V]) *treapNode[V] {
+	l, r := treapSplit(root, k)
+	return treapMerge(treapMerge(l, tn), r)
+}
+
+// treapIndexOf returns tn's in-order position within its treap, using parent pointers.
+func treapIndexOf[V comparable](tn *treapNode[V]) int {
+	idx := treapSize(tn.left)
+	for n := tn; n.parent != nil; n = n.parent {
+		if n == n.parent.right {
+			idx += treapSize(n.parent.left) + 1
+		}
+	}
+	return idx
+}
+
+// treapDeleteAt removes tn (whose current position is looked up via treapIndexOf) from root and
+// returns the new root.
+func treapDeleteAt[V comparable](root, tn *treapNode[V]) *treapNode[V] {
+	idx := treapIndexOf(tn)
+	l, r := treapSplit(root, idx)
+	_, r = treapSplit(r, 1) // drop the singleton holding tn
+	return treapMerge(l, r)
+}
+
+// treapAt descends root by subtree size to find the node at position i (0-based), or nil if out
+// of range.
+func treapAt[V comparable](root *treapNode[V], i int) *treapNode[V] {
+	t := root
+	for t != nil {
+		leftSize := treapSize(t.left)
+		switch {
+		case i < leftSize:
+			t = t.left
+		case i == leftSize:
+			return t
+		default:
+			i -= leftSize + 1
+			t = t.right
+		}
+	}
+	return nil
+}
+
+/*
+EnableOrderIndex builds an order-statistics index (a size-augmented treap keyed by insertion
+position) alongside the per-value nodes map, so that At, IndexOf and Range become available in
+O(log n). It is opt-in because the bookkeeping it adds to Append, Prepend and Delete is otherwise
+unwanted overhead.
+
+Calling EnableOrderIndex repeatedly rebuilds the index from the current contents of the list, which
+is also the way to recover from having used lnode's own Append/Prepend instead of LList's (compare
+FixCounts).
+*/
+func (l *LList[V]) EnableOrderIndex() {
+	l.indexMap = map[*lnode.Node[V]]*treapNode[V]{}
+	l.indexRoot = nil
+	i := 0
+	for n := range l.All() {
+		tn := &treapNode[V]{node: n, priority: rand.Uint64(), size: 1}
+		l.indexRoot = treapInsertAt(l.indexRoot, i, tn)
+		l.indexMap[n] = tn
+		i++
+	}
+}
+
+/*
+At returns the node at position i (0-based, head is position 0) in O(log n) time. At requires
+EnableOrderIndex to have been called; it returns nil if the index isn't enabled or i is out of
+range.
+*/
+func (l *LList[V]) At(i int) *lnode.Node[V] {
+	if l.indexRoot == nil || i < 0 || i >= treapSize(l.indexRoot) {
+		return nil
+	}
+	tn := treapAt(l.indexRoot, i)
+	if tn == nil {
+		return nil
+	}
+	return tn.node
+}
+
+/*
+IndexOf returns the 0-based position of node in O(log n) time, or -1 if the index isn't enabled or
+node isn't present in it.
+*/
+func (l *LList[V]) IndexOf(node *lnode.Node[V]) int {
+	if l.indexMap == nil {
+		return -1
+	}
+	tn, ok := l.indexMap[node]
+	if !ok {
+		return -1
+	}
+	return treapIndexOf(tn)
+}
+
+/*
+Range returns an iter.Seq over the nodes at positions [i, j), in O((j-i) log n) time, since each
+position is looked up separately. Range requires EnableOrderIndex to have been called; the sequence
+yields nothing otherwise or when the range is invalid.
+*/
+func (l *LList[V]) Range(i, j int) iter.Seq[*lnode.Node[V]] {
+	return func(yield func(*lnode.Node[V]) bool) {
+		if l.indexRoot == nil {
+			return
+		}
+		for k := i; k < j; k++ {
+			tn := treapAt(l.indexRoot, k)
+			if tn == nil {
+				return
+			}
+			if !yield(tn.node) {
+				return
+			}
+		}
+	}
+}
+
+// indexInsert adds n at position pos of the order index, if one is enabled. Called from Append
+// and Prepend.
+func (l *LList[V]) indexInsert(pos int, n *lnode.Node[V]) {
+	if l.indexMap == nil {
+		return
+	}
+	tn := &treapNode[V]{node: n, priority: rand.Uint64(), size: 1}
+	l.indexRoot = treapInsertAt(l.indexRoot, pos, tn)
+	l.indexMap[n] = tn
+}
+
+// indexDelete removes n from the order index, if one is enabled. Called from Delete.
+func (l *LList[V]) indexDelete(n *lnode.Node[V]) {
+	if l.indexMap == nil {
+		return
+	}
+	tn, ok := l.indexMap[n]
+	if !ok {
+		return
+	}
+	l.indexRoot = treapDeleteAt(l.indexRoot, tn)
+	delete(l.indexMap, n)
+}
@@ -0,0 +1,81 @@
+package llist
+
+import (
+	"testing"
+
+	"github.com/KarelKubat/lnode"
+)
+
+func TestAtAndIndexOf(t *testing.T) {
+	l := mkList()
+	l.EnableOrderIndex()
+
+	for i := range 10 {
+		n := l.At(i)
+		if n == nil || n.Value != i {
+			t.Fatalf("At(%d) = %v, want value %d", i, n, i)
+		}
+		if idx := l.IndexOf(n); idx != i {
+			t.Errorf("IndexOf(At(%d)) = %d, want %d", i, idx, i)
+		}
+	}
+
+	if n := l.At(10); n != nil {
+		t.Errorf("At(10) = %v, want nil (out of range)", n)
+	}
+}
+
+func TestOrderIndexTracksMutations(t *testing.T) {
+	l := mkList()
+	l.EnableOrderIndex()
+
+	// Delete the middle node (value 5) and check positions shift.
+	five := l.FindNodes(5)[0]
+	l.Delete(five)
+
+	want := []int{0, 1, 2, 3, 4, 6, 7, 8, 9}
+	for i, w := range want {
+		if n := l.At(i); n == nil || n.Value != w {
+			t.Errorf("after delete, At(%d) = %v, want value %d", i, n, w)
+		}
+	}
+
+	// Prepend and append and check the ends.
+	l.Prepend(l.Head(), lnode.New[int](-1))
+	l.Append(l.Tail(), lnode.New[int](100))
+	if n := l.At(0); n == nil || n.Value != -1 {
+		t.Errorf("At(0) = %v, want -1", n)
+	}
+	if n := l.At(l.IndexOf(l.Tail())); n == nil || n.Value != 100 {
+		t.Errorf("At(IndexOf(Tail())) = %v, want 100", n)
+	}
+}
+
+func TestRange(t *testing.T) {
+	l := mkList()
+	l.EnableOrderIndex()
+
+	var got []int
+	for n := range l.Range(2, 5) {
+		got = append(got, n.Value)
+	}
+	want := []int{2, 3, 4}
+	if len(got) != len(want) {
+		t.Fatalf("Range(2, 5) = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Range(2, 5) = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestWithoutOrderIndex(t *testing.T) {
+	l := mkList()
+	if n := l.At(0); n != nil {
+		t.Errorf("At(0) without EnableOrderIndex() = %v, want nil", n)
+	}
+	if idx := l.IndexOf(l.Head()); idx != -1 {
+		t.Errorf("IndexOf() without EnableOrderIndex() = %d, want -1", idx)
+	}
+}
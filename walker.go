@@ -0,0 +1,103 @@
+package llist
+
+import (
+	"github.com/KarelKubat/lnode"
+)
+
+/*
+Cursor is passed to the pre and post callbacks of Apply. It exposes the node currently being
+visited and lets callbacks rewrite the list around it without the caller having to juggle
+lnode.Append/lnode.Prepend and FixCounts() itself.
+*/
+type Cursor[V comparable] struct {
+	l       *LList[V]
+	node    *lnode.Node[V]
+	index   int
+	deleted bool
+}
+
+// Node returns the node currently being visited.
+func (c *Cursor[V]) Node() *lnode.Node[V] {
+	return c.node
+}
+
+/*
+Index returns the zero-based position of the node among those visited so far in the current Apply
+pass. It is not necessarily the node's absolute position in the list: InsertBefore() on an earlier
+node shifts everything after it without renumbering a pass already under way.
+*/
+func (c *Cursor[V]) Index() int {
+	return c.index
+}
+
+// Replace changes the value of the node currently being visited. See LList.SetValue().
+func (c *Cursor[V]) Replace(value V) {
+	c.l.SetValue(c.node, value)
+}
+
+// InsertBefore inserts a new node holding value immediately before the node currently being
+// visited. See LList.Prepend().
+func (c *Cursor[V]) InsertBefore(value V) {
+	c.l.Prepend(c.node, lnode.New(value))
+}
+
+/*
+InsertAfter inserts a new node holding value immediately after the node currently being visited. See
+LList.Append(). Since Apply continues from the current node's (possibly just-updated) Next pointer,
+a node inserted this way is visited next.
+*/
+func (c *Cursor[V]) InsertAfter(value V) {
+	c.l.Append(c.node, lnode.New(value))
+}
+
+// Delete removes the node currently being visited from the list. See LList.Delete(). post is not
+// called for a deleted node.
+func (c *Cursor[V]) Delete() {
+	next := c.node.Next
+	c.l.Delete(c.node)
+	c.node = next
+	c.deleted = true
+}
+
+/*
+Apply walks the list head to tail, calling pre and then post around each node, and rewrites it
+in-place through the Cursor: mutations performed via Replace(), InsertBefore(), InsertAfter() and
+Delete() are reflected in the nodes index and head/tail pointers as they happen, the same way the
+corresponding LList methods always behave. This gives callers a safe way to transform a list in a
+single pass, instead of mixing raw lnode.Append()/lnode.Prepend() calls with FixCounts().
+
+pre and post may be nil, in which case they are treated as always returning true. Returning false
+from pre skips post for the current node (there is nothing past it to descend into in a flat list,
+but the name mirrors astutil.Apply, which this is modeled after). Returning false from post aborts
+the walk. Nodes inserted via InsertAfter() are visited as Apply reaches them.
+*/
+func (l *LList[V]) Apply(pre, post func(*Cursor[V]) bool) {
+	index := 0
+	for n := l.Head(); n != nil; {
+		cur := &Cursor[V]{l: l, node: n, index: index}
+
+		visit := true
+		if pre != nil {
+			visit = pre(cur)
+		}
+
+		if cur.deleted {
+			n = cur.node
+			continue
+		}
+
+		if visit && post != nil {
+			if !post(cur) {
+				return
+			}
+		}
+
+		if cur.deleted {
+			n = cur.node
+			continue
+		}
+
+		n = cur.node.Next
+		index++
+	}
+}
@@ -0,0 +1,104 @@
+package llist
+
+import (
+	"testing"
+
+	"github.com/KarelKubat/lnode"
+)
+
+func TestApplyReplace(t *testing.T) {
+	l := mkList()
+	l.Apply(nil, func(c *Cursor[int]) bool {
+		c.Replace(c.Node().Value * 10)
+		return true
+	})
+
+	want := 0
+	for n := range l.All() {
+		if n.Value != want {
+			t.Errorf("Apply/Replace: got %d, want %d", n.Value, want)
+		}
+		want += 10
+	}
+}
+
+func TestApplyDeleteEven(t *testing.T) {
+	l := mkList()
+	l.Apply(func(c *Cursor[int]) bool {
+		if c.Node().Value%2 == 0 {
+			c.Delete()
+			return false
+		}
+		return true
+	}, nil)
+
+	want := 1
+	for n := range l.All() {
+		if n.Value != want {
+			t.Errorf("Apply/Delete: got %d, want %d", n.Value, want)
+		}
+		want += 2
+	}
+	if nds := l.FindNodes(4); len(nds) != 0 {
+		t.Errorf("Apply/Delete: FindNodes(4) = %d, want 0 (4 should have been deleted)", len(nds))
+	}
+}
+
+func TestApplyDeleteFromPost(t *testing.T) {
+	l := mkList() // 0..9
+	var visited []int
+	l.Apply(nil, func(c *Cursor[int]) bool {
+		visited = append(visited, c.Node().Value)
+		if c.Node().Value == 2 {
+			c.Delete()
+		}
+		return true
+	})
+
+	wantVisited := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if len(visited) != len(wantVisited) {
+		t.Fatalf("Apply/Delete from post: visited %v, want %v", visited, wantVisited)
+	}
+	for i, v := range wantVisited {
+		if visited[i] != v {
+			t.Errorf("Apply/Delete from post: visited[%d] = %d, want %d", i, visited[i], v)
+		}
+	}
+
+	if nds := l.FindNodes(2); len(nds) != 0 {
+		t.Errorf("Apply/Delete from post: FindNodes(2) = %d, want 0", len(nds))
+	}
+	if nds := l.FindNodes(3); len(nds) != 1 {
+		t.Errorf("Apply/Delete from post: FindNodes(3) = %d, want 1 (must not be skipped)", len(nds))
+	}
+}
+
+func TestApplyInsertAfterIsVisited(t *testing.T) {
+	l := New[int]()
+	l.Append(l.Tail(), lnode.New[int](1))
+
+	count := 0
+	l.Apply(nil, func(c *Cursor[int]) bool {
+		count++
+		if c.Node().Value == 1 {
+			c.InsertAfter(2)
+		}
+		return true
+	})
+
+	if count != 2 {
+		t.Errorf("Apply: visited %d nodes, want 2 (original plus the one inserted after it)", count)
+	}
+}
+
+func TestApplyAbort(t *testing.T) {
+	l := mkList()
+	visited := 0
+	l.Apply(nil, func(c *Cursor[int]) bool {
+		visited++
+		return c.Node().Value < 3
+	})
+	if visited != 4 {
+		t.Errorf("Apply: aborted after %d visits, want 4", visited)
+	}
+}